@@ -0,0 +1,72 @@
+package tview
+
+// AccessibilityProvider is implemented by components that can describe
+// themselves to an external accessibility exporter -- an AT-SPI bridge, a
+// test-automation harness, or similar -- mirroring the idea behind
+// Chromium's GetAccessibleNodeData. RadioButton and RadioButtonGroup are the
+// first primitives to implement it, since a radio group's "one of N"
+// semantics are the most distinct from a plain Checkbox and there was
+// previously no way for an outside observer to learn a button's position in
+// its set; other form primitives are expected to grow an analogous method
+// over time.
+type AccessibilityProvider interface {
+	// AccessibleRole returns a short, machine-readable description of what
+	// kind of control this is (e.g. "radio", "radiogroup").
+	AccessibleRole() string
+}
+
+// AccessibleRadioButtonInfo describes a RadioButton's semantics for an
+// AccessibilityProvider consumer.
+type AccessibleRadioButtonInfo struct {
+	Role          string // Always "radio".
+	GroupName     string // The parent group's Name, if any.
+	PositionInSet int    // This button's 1-based position within its group.
+	SetSize       int    // The number of buttons in the group.
+	Checked       bool
+	Label         string
+}
+
+// AccessibleRole returns "radio". It implements AccessibilityProvider.
+func (r *RadioButton) AccessibleRole() string {
+	return "radio"
+}
+
+// AccessibleInfo returns accessibility metadata describing this RadioButton.
+func (r *RadioButton) AccessibleInfo() AccessibleRadioButtonInfo {
+	return AccessibleRadioButtonInfo{
+		Role:          r.AccessibleRole(),
+		GroupName:     r.parent.Name(),
+		PositionInSet: r.parentIdx + 1,
+		SetSize:       r.parent.Len(),
+		Checked:       r.IsChecked(),
+		Label:         r.GetLabel(),
+	}
+}
+
+// AccessibleRadioGroupInfo describes a RadioButtonGroup's semantics for an
+// AccessibilityProvider consumer.
+type AccessibleRadioGroupInfo struct {
+	Role string // Always "radiogroup".
+	Name string
+	// SetSize is the number of buttons in the group.
+	SetSize int
+	// CheckedPosition is the 1-based position of the checked button within
+	// the group, or 0 if none is checked.
+	CheckedPosition int
+}
+
+// AccessibleRole returns "radiogroup". It implements AccessibilityProvider.
+func (g *RadioButtonGroup) AccessibleRole() string {
+	return "radiogroup"
+}
+
+// AccessibleInfo returns accessibility metadata describing this
+// RadioButtonGroup.
+func (g *RadioButtonGroup) AccessibleInfo() AccessibleRadioGroupInfo {
+	return AccessibleRadioGroupInfo{
+		Role:            g.AccessibleRole(),
+		Name:            g.name,
+		SetSize:         len(g.buttons),
+		CheckedPosition: g.checkedIdx + 1,
+	}
+}
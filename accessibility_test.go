@@ -0,0 +1,61 @@
+package tview
+
+import "testing"
+
+func TestRadioButtonAccessibleInfo(t *testing.T) {
+	g := NewRadioButtonGroup()
+	g.SetName("flavor")
+	buttons := g.AddOptions([]string{"Vanilla", "Chocolate", "Strawberry"})
+	buttons[1].SetChecked(true)
+
+	var p AccessibilityProvider = buttons[1]
+	if p.AccessibleRole() != "radio" {
+		t.Fatalf("AccessibleRole() = %q, want %q", p.AccessibleRole(), "radio")
+	}
+
+	info := buttons[1].AccessibleInfo()
+	want := AccessibleRadioButtonInfo{
+		Role:          "radio",
+		GroupName:     "flavor",
+		PositionInSet: 2,
+		SetSize:       3,
+		Checked:       true,
+		Label:         "Chocolate",
+	}
+	if info != want {
+		t.Fatalf("AccessibleInfo() = %+v, want %+v", info, want)
+	}
+
+	uncheckedInfo := buttons[0].AccessibleInfo()
+	if uncheckedInfo.Checked || uncheckedInfo.PositionInSet != 1 {
+		t.Fatalf("AccessibleInfo() for unchecked first button = %+v", uncheckedInfo)
+	}
+}
+
+func TestRadioButtonGroupAccessibleInfo(t *testing.T) {
+	g := NewRadioButtonGroup()
+	g.SetName("flavor")
+
+	var p AccessibilityProvider = g
+	if p.AccessibleRole() != "radiogroup" {
+		t.Fatalf("AccessibleRole() = %q, want %q", p.AccessibleRole(), "radiogroup")
+	}
+
+	buttons := g.AddOptions([]string{"Vanilla", "Chocolate", "Strawberry"})
+
+	if info := g.AccessibleInfo(); info.CheckedPosition != 0 {
+		t.Fatalf("AccessibleInfo().CheckedPosition = %d before any check, want 0", info.CheckedPosition)
+	}
+
+	buttons[2].SetChecked(true)
+
+	want := AccessibleRadioGroupInfo{
+		Role:            "radiogroup",
+		Name:            "flavor",
+		SetSize:         3,
+		CheckedPosition: 3,
+	}
+	if info := g.AccessibleInfo(); info != want {
+		t.Fatalf("AccessibleInfo() = %+v, want %+v", info, want)
+	}
+}
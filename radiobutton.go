@@ -32,6 +32,14 @@ type RadioButton struct {
 
 	parent    *RadioButtonGroup
 	parentIdx int
+
+	// Whether this button currently rejects focus and input. See
+	// SetDisabled.
+	disabled bool
+
+	// The colors this button was constructed with (from the parent group's
+	// defaults), so SetDisabled(false) can restore them after dimming.
+	baseLabelColor, baseFieldBackgroundColor, baseFieldTextColor tcell.Color
 }
 
 // SetChangedFunc sets a handler which is called when the checked state of
@@ -60,6 +68,142 @@ func (r *RadioButton) Parent() *RadioButtonGroup {
 	return r.parent
 }
 
+// SetDisabled sets whether this RadioButton rejects focus and input, dimming
+// its colors to indicate so. This is primarily meant for containers such as
+// RadioButtonGroupView that need to cascade a disabled state down to every
+// button in a group; most applications checking or unchecking buttons in
+// response to some other condition have no need to call this directly.
+//
+// Colors applied with SetLabelColor, SetFieldBackgroundColor or
+// SetFieldTextColor while disabled are overwritten the next time the button
+// is disabled or re-enabled. Re-enabling also discards any such call made
+// before the button was ever disabled: the colors restored are the ones the
+// parent group's defaults produced at construction time (see NewRadioButton),
+// captured once and not refreshed from the button's live colors afterwards.
+func (r *RadioButton) SetDisabled(disabled bool) *RadioButton {
+	if disabled == r.disabled {
+		return r
+	}
+	r.disabled = disabled
+	if disabled {
+		r.Checkbox.SetLabelColor(tcell.ColorGray)
+		r.Checkbox.SetFieldBackgroundColor(tcell.ColorGray)
+		r.Checkbox.SetFieldTextColor(tcell.ColorGray)
+	} else {
+		// Mirror the "leave it alone" guard RadioButtonGroup.NewRadioButton
+		// uses for these same fields: tcell.ColorDefault means "whatever
+		// NewCheckbox set up", not "literally ColorDefault", so forcing it
+		// here would stomp a Checkbox default this button never overrode.
+		if r.baseLabelColor != tcell.ColorDefault {
+			r.Checkbox.SetLabelColor(r.baseLabelColor)
+		}
+		if r.baseFieldBackgroundColor != tcell.ColorDefault {
+			r.Checkbox.SetFieldBackgroundColor(r.baseFieldBackgroundColor)
+		}
+		if r.baseFieldTextColor != tcell.ColorDefault {
+			r.Checkbox.SetFieldTextColor(r.baseFieldTextColor)
+		}
+	}
+	return r
+}
+
+// IsDisabled returns whether this RadioButton currently rejects focus and
+// input. See SetDisabled.
+func (r *RadioButton) IsDisabled() bool {
+	return r.disabled
+}
+
+// InputHandler returns the handler for this RadioButton's key events. Most
+// keys are forwarded to the underlying Checkbox unchanged. If the parent
+// group's focus traversal mode is FocusTraversalGroup:
+//
+//   - The arrow keys (Up and Left move to the previous button, Down and
+//     Right move to the next one, wrapping around if the group has been
+//     configured to do so via SetWrapNavigation) move focus within the
+//     group. If the group also has SetAutoCheckOnFocus enabled, the newly
+//     focused button is checked as well, matching how native radio groups
+//     usually behave.
+//   - Tab and Backtab treat the group as a single tab stop rather than
+//     stepping to the next/previous button in it: regardless of which
+//     button in the group currently has focus, Tab is handled as if it had
+//     been pressed on the last button in the group (so it advances past the
+//     group, the way Form's per-item finished-key handling ordinarily
+//     would), and Backtab as if pressed on the first. A button handles its
+//     own Tab/Backtab normally when it already *is* that boundary button.
+//
+// A disabled button (see SetDisabled) rejects every key except Tab and
+// Backtab, which it still lets through -- otherwise a button that ends up
+// focused while disabled would have no way to give up focus at all.
+func (r *RadioButton) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return r.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		key := event.Key()
+
+		// A disabled button still has to let Tab and Backtab through: if it
+		// somehow ended up focused anyway (e.g. it was individually disabled
+		// after FocusTarget/Focus already sent focus there), refusing those
+		// two as well would trap focus on it with no way to leave. Every
+		// other key is rejected, same as before.
+		if r.disabled && key != tcell.KeyTab && key != tcell.KeyBacktab {
+			return
+		}
+
+		if r.parent.focusTraversalMode == FocusTraversalGroup {
+			switch key {
+			case tcell.KeyUp, tcell.KeyLeft:
+				if !r.disabled {
+					if next := r.parent.adjacentEnabled(r.parentIdx, -1); next != nil {
+						r.parent.focusAndMaybeCheck(next, setFocus)
+						return
+					}
+				}
+			case tcell.KeyDown, tcell.KeyRight:
+				if !r.disabled {
+					if next := r.parent.adjacentEnabled(r.parentIdx, 1); next != nil {
+						r.parent.focusAndMaybeCheck(next, setFocus)
+						return
+					}
+				}
+			case tcell.KeyTab:
+				if last := r.parent.buttons[r.parent.Len()-1]; last != r {
+					if handler := last.Checkbox.InputHandler(); handler != nil {
+						handler(event, setFocus)
+					}
+					return
+				}
+			case tcell.KeyBacktab:
+				if first := r.parent.buttons[0]; first != r {
+					if handler := first.Checkbox.InputHandler(); handler != nil {
+						handler(event, setFocus)
+					}
+					return
+				}
+			}
+		}
+
+		if handler := r.Checkbox.InputHandler(); handler != nil {
+			handler(event, setFocus)
+		}
+	})
+}
+
+// FocusTraversalMode determines how tab-focus and arrow keys move between
+// the RadioButton instances of a RadioButtonGroup.
+type FocusTraversalMode int
+
+const (
+	// FocusTraversalIndividual treats every RadioButton in the group as its
+	// own tab stop, same as any other sequence of primitives. This is the
+	// default, for backwards compatibility.
+	FocusTraversalIndividual FocusTraversalMode = iota
+
+	// FocusTraversalGroup treats the whole group as a single tab stop: Up,
+	// Down, Left and Right move focus between the buttons in the group
+	// (checking the newly focused button too, if SetAutoCheckOnFocus is
+	// enabled), modeled on Chromium's IsGroupFocusTraversable and the radio
+	// group behavior of most native toolkits.
+	FocusTraversalGroup
+)
+
 // RadioButtonGroup associates a set of radio buttons with each other. Exactly
 // one radio button in a group is allowed to be checked at once.
 //
@@ -103,6 +247,27 @@ type RadioButtonGroup struct {
 
 	// User-provided callback.
 	hasFinishedChanging func(*RadioButton, *RadioButton)
+
+	// User-provided callback, consulted by react before it commits to a
+	// change of state.
+	beforeChange func(from, to *RadioButton) bool
+
+	// How Tab-focus and arrow keys move between this group's buttons. See
+	// FocusTraversalMode.
+	focusTraversalMode FocusTraversalMode
+
+	// If true, and focusTraversalMode is FocusTraversalGroup, moving focus
+	// with an arrow key also checks the newly focused button.
+	autoCheckOnFocus bool
+
+	// If true, arrow-key navigation wraps from the last button back to the
+	// first (and vice versa) instead of stopping there.
+	wrapNavigation bool
+
+	// An application-chosen identifier for this group, exposed to
+	// accessibility exporters via AccessibleInfo. Purely cosmetic; not used
+	// by RadioButtonGroup itself.
+	name string
 }
 
 // NewRadioButtonGroup creates a new RadioButtonGroup with no buttons. `o` is
@@ -120,6 +285,19 @@ func NewRadioButtonGroup() *RadioButtonGroup {
 	}
 }
 
+// NewRadioButtonGroupWithOptions creates a new RadioButtonGroup already
+// populated with one RadioButton per entry in labels, in order (see
+// AddOptions). If initialIdx is non-negative, the button at that index is
+// checked; provide a negative initialIdx to leave nothing checked.
+func NewRadioButtonGroupWithOptions(labels []string, initialIdx int) *RadioButtonGroup {
+	g := NewRadioButtonGroup()
+	g.AddOptions(labels)
+	if initialIdx >= 0 {
+		g.Check(initialIdx)
+	}
+	return g
+}
+
 // NewRadioButton returns a new RadioButton assigned to this group.
 //
 // RadioButtons cannot be removed from the group once they are created.
@@ -146,15 +324,34 @@ func (g *RadioButtonGroup) NewRadioButton() *RadioButton {
 	})
 
 	r := &RadioButton{
-		Checkbox:  c,
-		parent:    g,
-		parentIdx: i,
+		Checkbox:                 c,
+		parent:                   g,
+		parentIdx:                i,
+		baseLabelColor:           g.labelColor,
+		baseFieldBackgroundColor: g.fieldBackgroundColor,
+		baseFieldTextColor:       g.fieldTextColor,
+		changed:                  func(bool) {},
 	}
 
 	g.buttons = append(g.buttons, r)
 	return r
 }
 
+// AddOptions creates one RadioButton per entry in labels, in order, applying
+// the group's configured defaults to each (see NewRadioButton), and returns
+// the new buttons. This is a convenience for the common case of a fixed set
+// of options, saving a manual loop over NewRadioButton followed by
+// SetLabel.
+func (g *RadioButtonGroup) AddOptions(labels []string) []*RadioButton {
+	buttons := make([]*RadioButton, len(labels))
+	for i, label := range labels {
+		r := g.NewRadioButton()
+		r.SetLabel(label)
+		buttons[i] = r
+	}
+	return buttons
+}
+
 // SetStayChecked configures whether this group prevents the selected
 // RadioButton from becoming unchecked by means other than checking a new one.
 // This includes direct calls to the SetChecked functions on those
@@ -167,6 +364,109 @@ func (g *RadioButtonGroup) SetStayChecked(stayChecked bool) {
 	g.stayChecked = stayChecked
 }
 
+// SetFocusTraversalMode configures how Tab-focus and arrow keys move between
+// this group's buttons. See FocusTraversalMode for the available modes. The
+// default is FocusTraversalIndividual.
+func (g *RadioButtonGroup) SetFocusTraversalMode(mode FocusTraversalMode) {
+	g.focusTraversalMode = mode
+}
+
+// SetAutoCheckOnFocus configures whether moving focus between this group's
+// buttons with an arrow key (under FocusTraversalGroup) also checks the
+// newly focused button. It has no effect under FocusTraversalIndividual.
+func (g *RadioButtonGroup) SetAutoCheckOnFocus(auto bool) {
+	g.autoCheckOnFocus = auto
+}
+
+// SetName sets an application-chosen identifier for this group. It has no
+// effect on the group's behavior; it is only exposed to accessibility
+// exporters via AccessibleInfo.
+func (g *RadioButtonGroup) SetName(name string) {
+	g.name = name
+}
+
+// Name returns the identifier set with SetName, or "" if none was set.
+func (g *RadioButtonGroup) Name() string {
+	return g.name
+}
+
+// SetWrapNavigation configures whether arrow-key navigation between this
+// group's buttons (under FocusTraversalGroup) wraps around: moving past the
+// last button goes to the first, and moving before the first goes to the
+// last. The default is false.
+func (g *RadioButtonGroup) SetWrapNavigation(wrap bool) {
+	g.wrapNavigation = wrap
+}
+
+// FocusTarget returns the button that should receive focus when tab-focus
+// enters this group under FocusTraversalGroup: the checked button, or the
+// first button if none is checked. Either way, a disabled button (see
+// RadioButton.SetDisabled) is skipped in favor of the next enabled one,
+// searching forward and wrapping around the whole group regardless of
+// SetWrapNavigation (which only governs arrow-key movement, not where focus
+// should initially land), since a disabled button rejects every key except
+// Tab and Backtab (see RadioButton.InputHandler). It returns nil
+// if the group has no buttons, or if every button in it is disabled.
+func (g *RadioButtonGroup) FocusTarget() *RadioButton {
+	if len(g.buttons) == 0 {
+		return nil
+	}
+
+	start := g.checkedIdx
+	if start < 0 {
+		start = 0
+	}
+
+	for i := 0; i < len(g.buttons); i++ {
+		if b := g.buttons[(start+i)%len(g.buttons)]; !b.disabled {
+			return b
+		}
+	}
+	return nil
+}
+
+// adjacent returns the button next to the one at idx in the given direction
+// (-1 for previous, 1 for next), honoring wrapNavigation. It returns nil if
+// there is no such button.
+func (g *RadioButtonGroup) adjacent(idx, direction int) *RadioButton {
+	next := idx + direction
+	if next < 0 || next >= len(g.buttons) {
+		if !g.wrapNavigation {
+			return nil
+		}
+		next = (next + len(g.buttons)) % len(g.buttons)
+	}
+	return g.buttons[next]
+}
+
+// adjacentEnabled is like adjacent, but skips over any disabled button (see
+// RadioButton.SetDisabled) in the given direction, continuing past it
+// instead of stopping there. It returns nil if there is no enabled button to
+// be found, including when every other button in the group is disabled.
+func (g *RadioButtonGroup) adjacentEnabled(idx, direction int) *RadioButton {
+	start := idx
+	for range g.buttons {
+		next := g.adjacent(idx, direction)
+		if next == nil || next.parentIdx == start {
+			return nil
+		}
+		if !next.disabled {
+			return next
+		}
+		idx = next.parentIdx
+	}
+	return nil
+}
+
+// focusAndMaybeCheck moves focus to the given button and, if
+// autoCheckOnFocus is enabled, checks it too.
+func (g *RadioButtonGroup) focusAndMaybeCheck(r *RadioButton, setFocus func(p Primitive)) {
+	setFocus(r)
+	if g.autoCheckOnFocus {
+		r.SetChecked(true)
+	}
+}
+
 // Len returns the number of RadioButton instances associated with this
 // RadioButtonGroup.
 func (g *RadioButtonGroup) Len() int {
@@ -272,6 +572,22 @@ func (g *RadioButtonGroup) SetFinishedChangingFunc(f func(unchecked, checked *Ra
 	g.hasFinishedChanging = f
 }
 
+// SetBeforeChangeFunc sets a handler which is asked to approve a pending
+// change of checked state before it takes effect. It receives the button
+// about to become unchecked (from, or nil if none was checked before) and
+// the one about to become checked (to, or nil if the group is being
+// cleared). If it returns false, the change is reverted -- from (if any)
+// stays checked, or stays unchecked -- before react updates checkedIdx or
+// prevCheckedIdx, and without invoking any Changed or FinishedChanging
+// handler.
+//
+// This is the supported way to implement things like "confirm discarding
+// unsaved changes before switching modes"; reversing state from within a
+// Changed handler instead is explicitly unsupported (see RadioButton.changed).
+func (g *RadioButtonGroup) SetBeforeChangeFunc(f func(from, to *RadioButton) bool) {
+	g.beforeChange = f
+}
+
 // react updates checked-ness states of child RadioButton instances in response
 // to a change callback from one of its children (which has already changed its
 // state, although it may get changed back). It sets g.ignoreEvent for the
@@ -296,15 +612,29 @@ func (g *RadioButtonGroup) react(idx int, newState bool) (unchecked, checked *Ra
 	if newState {
 		// Checking a box.
 		if g.checkedIdx == -1 {
+			if g.beforeChange != nil && !g.beforeChange(nil, target) {
+				// Vetoed. Revert target, which already reported itself as
+				// checked; the event this produces is discarded (due to
+				// g.ignoreEvent).
+				target.SetChecked(false)
+				return nil, nil
+			}
+
 			// No box to uncheck; just change our state.
 			g.prevCheckedIdx = -1
 			g.checkedIdx = idx
 			return nil, target
 		}
 
+		former := g.buttons[g.checkedIdx]
+		if g.beforeChange != nil && !g.beforeChange(former, target) {
+			// Vetoed. former was never touched; just revert target.
+			target.SetChecked(false)
+			return nil, nil
+		}
+
 		// Uncheck the old box. Note that the event from doing this will be
 		// discarded (due to g.ignoreEvent).
-		former := g.buttons[g.checkedIdx]
 		former.SetChecked(false)
 
 		g.prevCheckedIdx = g.checkedIdx
@@ -322,6 +652,14 @@ func (g *RadioButtonGroup) react(idx int, newState bool) (unchecked, checked *Ra
 		return nil, nil
 	}
 
+	if g.beforeChange != nil && !g.beforeChange(target, nil) {
+		// Vetoed. Revert target, which already reported itself as
+		// unchecked; the event this produces is discarded (due to
+		// g.ignoreEvent).
+		target.SetChecked(true)
+		return nil, nil
+	}
+
 	// No other boxes need to be updated; just change our state.
 	g.prevCheckedIdx = g.checkedIdx
 	g.checkedIdx = -1
@@ -0,0 +1,183 @@
+package tview
+
+import "testing"
+
+func TestRadioButtonGroupBeforeChangeFuncVetoesInitialCheck(t *testing.T) {
+	g := NewRadioButtonGroup()
+	a := g.NewRadioButton()
+	g.NewRadioButton()
+
+	g.SetBeforeChangeFunc(func(from, to *RadioButton) bool {
+		return false
+	})
+
+	var changedCalls, finishedCalls int
+	a.SetChangedFunc(func(checked bool) { changedCalls++ })
+	g.SetFinishedChangingFunc(func(unchecked, checked *RadioButton) { finishedCalls++ })
+
+	a.SetChecked(true)
+
+	if a.IsChecked() {
+		t.Fatal("a should have been reverted to unchecked by the veto")
+	}
+	if g.Checked() != nil {
+		t.Fatalf("g.Checked() = %v, want nil", g.Checked())
+	}
+	if changedCalls != 0 || finishedCalls != 0 {
+		t.Fatalf("veto leaked callbacks: changedCalls=%d finishedCalls=%d", changedCalls, finishedCalls)
+	}
+}
+
+func TestRadioButtonGroupBeforeChangeFuncVetoesSwitch(t *testing.T) {
+	g := NewRadioButtonGroup()
+	a := g.NewRadioButton()
+	b := g.NewRadioButton()
+
+	a.SetChecked(true)
+
+	g.SetBeforeChangeFunc(func(from, to *RadioButton) bool {
+		return from != a // Allow anything except leaving a.
+	})
+
+	var finishedCalls int
+	g.SetFinishedChangingFunc(func(unchecked, checked *RadioButton) { finishedCalls++ })
+
+	b.SetChecked(true)
+
+	if !a.IsChecked() {
+		t.Fatal("a should still be checked; the switch to b should have been vetoed")
+	}
+	if b.IsChecked() {
+		t.Fatal("b should have been reverted to unchecked by the veto")
+	}
+	if g.Checked() != a {
+		t.Fatalf("g.Checked() = %v, want a", g.Checked())
+	}
+	if finishedCalls != 0 {
+		t.Fatalf("veto leaked a FinishedChanging callback: finishedCalls=%d", finishedCalls)
+	}
+}
+
+func TestRadioButtonGroupBeforeChangeFuncVetoesUncheck(t *testing.T) {
+	g := NewRadioButtonGroup()
+	g.SetStayChecked(false)
+	a := g.NewRadioButton()
+	a.SetChecked(true)
+
+	g.SetBeforeChangeFunc(func(from, to *RadioButton) bool {
+		return to != nil // Disallow clearing the group.
+	})
+
+	var finishedCalls int
+	g.SetFinishedChangingFunc(func(unchecked, checked *RadioButton) { finishedCalls++ })
+
+	a.SetChecked(false)
+
+	if !a.IsChecked() {
+		t.Fatal("a should have been reverted back to checked by the veto")
+	}
+	if g.Checked() != a {
+		t.Fatalf("g.Checked() = %v, want a", g.Checked())
+	}
+	if finishedCalls != 0 {
+		t.Fatalf("veto leaked a FinishedChanging callback: finishedCalls=%d", finishedCalls)
+	}
+}
+
+func TestRadioButtonGroupFocusTarget(t *testing.T) {
+	g := NewRadioButtonGroup()
+	if g.FocusTarget() != nil {
+		t.Fatal("FocusTarget() on an empty group should be nil")
+	}
+
+	a := g.NewRadioButton()
+	b := g.NewRadioButton()
+	if g.FocusTarget() != a {
+		t.Fatalf("FocusTarget() with nothing checked = %v, want the first button", g.FocusTarget())
+	}
+
+	b.SetChecked(true)
+	if g.FocusTarget() != b {
+		t.Fatalf("FocusTarget() = %v, want the checked button", g.FocusTarget())
+	}
+}
+
+func TestRadioButtonGroupAdjacentWrapping(t *testing.T) {
+	g := NewRadioButtonGroup()
+	a := g.NewRadioButton()
+	b := g.NewRadioButton()
+	c := g.NewRadioButton()
+
+	if got := g.adjacent(0, -1); got != nil {
+		t.Fatalf("adjacent(0, -1) without wrap = %v, want nil", got)
+	}
+	if got := g.adjacent(2, 1); got != nil {
+		t.Fatalf("adjacent(2, 1) without wrap = %v, want nil", got)
+	}
+	if got := g.adjacent(1, 1); got != c {
+		t.Fatalf("adjacent(1, 1) = %v, want c", got)
+	}
+	if got := g.adjacent(1, -1); got != a {
+		t.Fatalf("adjacent(1, -1) = %v, want a", got)
+	}
+
+	g.SetWrapNavigation(true)
+	if got := g.adjacent(0, -1); got != c {
+		t.Fatalf("adjacent(0, -1) with wrap = %v, want c", got)
+	}
+	if got := g.adjacent(2, 1); got != a {
+		t.Fatalf("adjacent(2, 1) with wrap = %v, want a", got)
+	}
+}
+
+func TestRadioButtonGroupFocusAndMaybeCheck(t *testing.T) {
+	g := NewRadioButtonGroup()
+	a := g.NewRadioButton()
+	b := g.NewRadioButton()
+	a.SetChecked(true)
+
+	var focused Primitive
+	g.focusAndMaybeCheck(b, func(p Primitive) { focused = p })
+	if focused != b {
+		t.Fatalf("focusAndMaybeCheck did not call setFocus with b")
+	}
+	if b.IsChecked() {
+		t.Fatal("focusAndMaybeCheck checked b despite SetAutoCheckOnFocus not being enabled")
+	}
+
+	g.SetAutoCheckOnFocus(true)
+	g.focusAndMaybeCheck(b, func(p Primitive) { focused = p })
+	if !b.IsChecked() {
+		t.Fatal("focusAndMaybeCheck should have checked b once SetAutoCheckOnFocus was enabled")
+	}
+}
+
+func TestRadioButtonGroupBeforeChangeFuncApprovedChangeStillFires(t *testing.T) {
+	g := NewRadioButtonGroup()
+	a := g.NewRadioButton()
+	b := g.NewRadioButton()
+	a.SetChecked(true)
+
+	var vetoCalls int
+	g.SetBeforeChangeFunc(func(from, to *RadioButton) bool {
+		vetoCalls++
+		return true
+	})
+
+	var unchecked, checked *RadioButton
+	g.SetFinishedChangingFunc(func(u, c *RadioButton) {
+		unchecked, checked = u, c
+	})
+
+	b.SetChecked(true)
+
+	if vetoCalls != 1 {
+		t.Fatalf("beforeChange called %d times, want 1", vetoCalls)
+	}
+	if unchecked != a || checked != b {
+		t.Fatalf("FinishedChangingFunc saw unchecked=%v checked=%v, want a, b", unchecked, checked)
+	}
+	if !b.IsChecked() || a.IsChecked() {
+		t.Fatal("approved switch from a to b did not take effect")
+	}
+}
@@ -0,0 +1,47 @@
+package tview
+
+import "testing"
+
+func TestRadioButtonGroupAddOptions(t *testing.T) {
+	g := NewRadioButtonGroup()
+	buttons := g.AddOptions([]string{"Red", "Green", "Blue"})
+
+	if len(buttons) != 3 {
+		t.Fatalf("AddOptions returned %d buttons, want 3", len(buttons))
+	}
+	if g.Len() != 3 {
+		t.Fatalf("g.Len() = %d, want 3", g.Len())
+	}
+
+	for i, want := range []string{"Red", "Green", "Blue"} {
+		if got := g.Get(i).GetLabel(); got != want {
+			t.Fatalf("button %d label = %q, want %q", i, got, want)
+		}
+		if buttons[i] != g.Get(i) {
+			t.Fatalf("AddOptions()[%d] != Get(%d)", i, i)
+		}
+	}
+
+	if g.Checked() != nil {
+		t.Fatal("AddOptions should not check anything on its own")
+	}
+}
+
+func TestNewRadioButtonGroupWithOptions(t *testing.T) {
+	g := NewRadioButtonGroupWithOptions([]string{"a", "b", "c"}, 1)
+
+	if g.Len() != 3 {
+		t.Fatalf("g.Len() = %d, want 3", g.Len())
+	}
+	if checked := g.Checked(); checked == nil || checked.Index() != 1 {
+		t.Fatalf("g.Checked() = %v, want button at index 1", checked)
+	}
+}
+
+func TestNewRadioButtonGroupWithOptionsNegativeIndexChecksNothing(t *testing.T) {
+	g := NewRadioButtonGroupWithOptions([]string{"a", "b"}, -1)
+
+	if g.Checked() != nil {
+		t.Fatalf("g.Checked() = %v, want nil with a negative initialIdx", g.Checked())
+	}
+}
@@ -0,0 +1,134 @@
+package tview
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// RadioButtonGroupOf wraps a RadioButtonGroup and associates an arbitrary
+// value of type T with each of its buttons, similar to Cursive's
+// RadioGroup<T> or the value field carried by Walk's radio buttons. It lets
+// applications bind a group of radio buttons directly to an enum or model
+// value instead of maintaining a parallel index -> value table.
+type RadioButtonGroupOf[T any] struct {
+	*RadioButtonGroup
+
+	// values[i] is the value associated with the button at index i.
+	values []T
+
+	// User-provided callback for SetFinishedChangingFunc, since
+	// RadioButtonGroupOf needs the RadioButtonGroup's own hook for itself to
+	// implement SetFinishedChangingValueFunc.
+	finishedChanging func(unchecked, checked *RadioButton)
+
+	// User-provided callback.
+	finishedChangingValue func(old, new T, ok bool)
+}
+
+// NewRadioButtonGroupOf creates a new RadioButtonGroupOf with no buttons.
+func NewRadioButtonGroupOf[T any]() *RadioButtonGroupOf[T] {
+	g := &RadioButtonGroupOf[T]{
+		RadioButtonGroup: NewRadioButtonGroup(),
+	}
+	g.RadioButtonGroup.SetFinishedChangingFunc(g.handleFinishedChanging)
+	return g
+}
+
+// NewRadioButton returns a new RadioButton assigned to this group, labeled
+// with label and associated with value. Use SelectedValue, CheckByValue and
+// SetFinishedChangingValueFunc to interact with the group in terms of value
+// instead of *RadioButton or index.
+func (g *RadioButtonGroupOf[T]) NewRadioButton(label string, value T) *RadioButton {
+	r := g.RadioButtonGroup.NewRadioButton()
+	r.SetLabel(label)
+	g.values = append(g.values, value)
+	return r
+}
+
+// AddOptions shadows the RadioButtonGroup method of the same name promoted
+// by embedding: that version only knows how to build plain RadioButton
+// instances, so calling it here would grow the underlying group's buttons
+// without growing values to match, leaving values out of sync (promoted
+// methods cannot see this type's override of NewRadioButton). Use this
+// instead to build one RadioButton per (label, value) pair, in order.
+//
+// labels and values must be the same length, or this panics.
+func (g *RadioButtonGroupOf[T]) AddOptions(labels []string, values []T) []*RadioButton {
+	if len(labels) != len(values) {
+		panic(fmt.Errorf("AddOptions needs as many values as labels, got %d labels and %d values", len(labels), len(values)))
+	}
+	buttons := make([]*RadioButton, len(labels))
+	for i, label := range labels {
+		buttons[i] = g.NewRadioButton(label, values[i])
+	}
+	return buttons
+}
+
+// SelectedValue returns the value associated with the currently checked
+// button, and true. If no button is checked, it returns the zero value of T
+// and false.
+func (g *RadioButtonGroupOf[T]) SelectedValue() (value T, ok bool) {
+	checked := g.Checked()
+	if checked == nil {
+		return value, false
+	}
+	return g.values[checked.Index()], true
+}
+
+// CheckByValue checks the button associated with value (via
+// reflect.DeepEqual) and returns true. If no button is associated with an
+// equal value, it leaves the group's state unchanged and returns false.
+// The result only reports whether a matching button was found: it is true
+// even when a BeforeChangeFunc vetoes checking that button.
+func (g *RadioButtonGroupOf[T]) CheckByValue(value T) bool {
+	for i, v := range g.values {
+		if reflect.DeepEqual(v, value) {
+			g.Check(i)
+			return true
+		}
+	}
+	return false
+}
+
+// SetFinishedChangingFunc overrides the RadioButtonGroup method of the same
+// name embedded in RadioButtonGroupOf, which is already in use relaying
+// events to SetFinishedChangingValueFunc. It behaves identically otherwise.
+func (g *RadioButtonGroupOf[T]) SetFinishedChangingFunc(f func(unchecked, checked *RadioButton)) {
+	g.finishedChanging = f
+}
+
+// SetFinishedChangingValueFunc configures a callback to be invoked when the
+// group changes which value it has selected, in terms of the values
+// associated with its buttons rather than the buttons themselves. old and
+// new are the values of the previously- and newly-checked buttons; either
+// may be the zero value of T if there was no such button (see
+// RadioButtonGroup.SetFinishedChangingFunc for when that happens). ok
+// reports whether a button ended up checked at all, i.e. whether new is
+// meaningful.
+func (g *RadioButtonGroupOf[T]) SetFinishedChangingValueFunc(f func(old, new T, ok bool)) {
+	g.finishedChangingValue = f
+}
+
+// handleFinishedChanging is installed as the underlying RadioButtonGroup's
+// FinishedChangingFunc so that RadioButtonGroupOf can translate the event
+// into value terms before (optionally) also forwarding it to a
+// SetFinishedChangingFunc callback of its own.
+func (g *RadioButtonGroupOf[T]) handleFinishedChanging(unchecked, checked *RadioButton) {
+	if g.finishedChanging != nil {
+		g.finishedChanging(unchecked, checked)
+	}
+
+	if g.finishedChangingValue == nil {
+		return
+	}
+
+	var old, new_ T
+	if unchecked != nil {
+		old = g.values[unchecked.Index()]
+	}
+	ok := checked != nil
+	if ok {
+		new_ = g.values[checked.Index()]
+	}
+	g.finishedChangingValue(old, new_, ok)
+}
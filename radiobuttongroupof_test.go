@@ -0,0 +1,89 @@
+package tview
+
+import "testing"
+
+func TestRadioButtonGroupOfAddOptionsKeepsValuesInLockstep(t *testing.T) {
+	g := NewRadioButtonGroupOf[string]()
+	buttons := g.AddOptions([]string{"a", "b", "c"}, []string{"A", "B", "C"})
+	if len(buttons) != 3 {
+		t.Fatalf("expected 3 buttons, got %d", len(buttons))
+	}
+
+	// This used to panic: the embedded RadioButtonGroup.AddOptions promoted
+	// method grew buttons without growing values to match.
+	buttons[1].SetChecked(true)
+
+	value, ok := g.SelectedValue()
+	if !ok || value != "B" {
+		t.Fatalf("SelectedValue() = %q, %v; want %q, true", value, ok, "B")
+	}
+}
+
+func TestRadioButtonGroupOfAddOptionsLengthMismatchPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected AddOptions to panic on mismatched lengths")
+		}
+	}()
+
+	g := NewRadioButtonGroupOf[int]()
+	g.AddOptions([]string{"a", "b"}, []int{1})
+}
+
+func TestRadioButtonGroupOfCheckByValue(t *testing.T) {
+	g := NewRadioButtonGroupOf[int]()
+	g.AddOptions([]string{"one", "two", "three"}, []int{1, 2, 3})
+
+	if !g.CheckByValue(2) {
+		t.Fatal("CheckByValue(2) = false, want true")
+	}
+	if value, ok := g.SelectedValue(); !ok || value != 2 {
+		t.Fatalf("SelectedValue() = %d, %v; want 2, true", value, ok)
+	}
+
+	if g.CheckByValue(99) {
+		t.Fatal("CheckByValue(99) = true, want false")
+	}
+	if value, ok := g.SelectedValue(); !ok || value != 2 {
+		t.Fatalf("SelectedValue() after failed CheckByValue = %d, %v; want unchanged 2, true", value, ok)
+	}
+}
+
+func TestRadioButtonGroupOfFinishedChangingValueFunc(t *testing.T) {
+	g := NewRadioButtonGroupOf[string]()
+	buttons := g.AddOptions([]string{"a", "b"}, []string{"A", "B"})
+
+	var gotOld, gotNew string
+	var gotOK bool
+	var calls int
+	g.SetFinishedChangingValueFunc(func(old, new string, ok bool) {
+		calls++
+		gotOld, gotNew, gotOK = old, new, ok
+	})
+
+	buttons[0].SetChecked(true)
+	if calls != 1 || gotOld != "" || gotNew != "A" || !gotOK {
+		t.Fatalf("after checking a: calls=%d old=%q new=%q ok=%v", calls, gotOld, gotNew, gotOK)
+	}
+
+	buttons[1].SetChecked(true)
+	if calls != 2 || gotOld != "A" || gotNew != "B" || !gotOK {
+		t.Fatalf("after checking b: calls=%d old=%q new=%q ok=%v", calls, gotOld, gotNew, gotOK)
+	}
+}
+
+func TestRadioButtonGroupOfSetFinishedChangingFuncStillFires(t *testing.T) {
+	g := NewRadioButtonGroupOf[string]()
+	buttons := g.AddOptions([]string{"a"}, []string{"A"})
+
+	var sawUnchecked, sawChecked *RadioButton
+	g.SetFinishedChangingFunc(func(unchecked, checked *RadioButton) {
+		sawUnchecked, sawChecked = unchecked, checked
+	})
+
+	buttons[0].SetChecked(true)
+
+	if sawChecked != buttons[0] || sawUnchecked != nil {
+		t.Fatalf("SetFinishedChangingFunc callback did not see the expected buttons")
+	}
+}
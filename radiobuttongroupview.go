@@ -0,0 +1,160 @@
+package tview
+
+import "github.com/gdamore/tcell/v2"
+
+// Orientation determines the layout direction of a RadioButtonGroupView's
+// buttons.
+type Orientation int
+
+const (
+	// OrientationVertical stacks buttons top to bottom, one per row.
+	OrientationVertical Orientation = iota
+
+	// OrientationHorizontal lays buttons out side by side, left to right.
+	OrientationHorizontal
+)
+
+// RadioButtonGroupView is a drawable Box wrapping a RadioButtonGroup and
+// laying out its buttons automatically, analogous to how Android's
+// RadioGroup extends LinearLayout. Unlike a bare RadioButtonGroup, which is
+// explicitly not a drawable widget and must have its buttons added to a
+// Flex or Form by hand, a RadioButtonGroupView can be added to a layout on
+// its own.
+//
+// The container itself is the Primitive that should be registered with
+// Application.SetFocus; focus is delegated to the group's checked button
+// (or its first button, if none is checked) when that happens.
+//
+// The underlying group defaults to FocusTraversalGroup (see
+// RadioButtonGroup.SetFocusTraversalMode), so arrow keys move focus among
+// the buttons (checking the newly focused one only if
+// Group().SetAutoCheckOnFocus(true) is called) and Tab/Backtab leave the
+// view, the way a single native control behaves; call Group().SetFocusTraversalMode(
+// FocusTraversalIndividual) to opt back into per-button Tab stops.
+type RadioButtonGroupView struct {
+	*Box
+
+	group *RadioButtonGroup
+
+	orientation Orientation
+
+	// Whether this view's buttons currently accept focus and input. See
+	// SetEnabled.
+	enabled bool
+
+	// Whether SetEnabled cascades to the group's buttons. See
+	// SetInheritEnabled.
+	inheritEnabled bool
+
+	// Arranges the buttons according to orientation. Buttons are added to
+	// this as they are added to group.
+	layout *Flex
+}
+
+// NewRadioButtonGroupView creates a new RadioButtonGroupView with no
+// buttons, laid out vertically.
+func NewRadioButtonGroupView() *RadioButtonGroupView {
+	v := &RadioButtonGroupView{
+		Box:            NewBox(),
+		group:          NewRadioButtonGroup(),
+		enabled:        true,
+		inheritEnabled: true,
+		layout:         NewFlex().SetDirection(FlexRow),
+	}
+	v.group.SetFocusTraversalMode(FocusTraversalGroup)
+	return v
+}
+
+// Group returns the RadioButtonGroup this view manages. Use it for anything
+// not covered by the view itself, such as SetStayChecked,
+// SetBeforeChangeFunc, SetFinishedChangingFunc, or reading back Checked and
+// PreviouslyChecked.
+func (v *RadioButtonGroupView) Group() *RadioButtonGroup {
+	return v.group
+}
+
+// NewRadioButton adds a new RadioButton to the group, labeled with label,
+// and returns it.
+func (v *RadioButtonGroupView) NewRadioButton(label string) *RadioButton {
+	r := v.group.NewRadioButton()
+	r.SetLabel(label)
+	r.SetDisabled(!v.enabled && v.inheritEnabled)
+	v.layout.AddItem(r, 0, 1, false)
+	return r
+}
+
+// AddOptions adds one RadioButton per label to the group, in order, and
+// returns the new buttons. See RadioButtonGroup.AddOptions.
+func (v *RadioButtonGroupView) AddOptions(labels []string) []*RadioButton {
+	buttons := make([]*RadioButton, len(labels))
+	for i, label := range labels {
+		buttons[i] = v.NewRadioButton(label)
+	}
+	return buttons
+}
+
+// SetOrientation sets whether this view's buttons are laid out vertically
+// (OrientationVertical, the default) or horizontally (OrientationHorizontal).
+func (v *RadioButtonGroupView) SetOrientation(orientation Orientation) *RadioButtonGroupView {
+	v.orientation = orientation
+	if orientation == OrientationHorizontal {
+		v.layout.SetDirection(FlexColumn)
+	} else {
+		v.layout.SetDirection(FlexRow)
+	}
+	return v
+}
+
+// SetEnabled sets whether this view's buttons accept focus and input. If
+// SetInheritEnabled is in effect (the default), this cascades to every
+// button currently in the group, dimming them while disabled; buttons added
+// afterwards pick up the current state too.
+func (v *RadioButtonGroupView) SetEnabled(enabled bool) *RadioButtonGroupView {
+	v.enabled = enabled
+	if v.inheritEnabled {
+		for i := 0; i < v.group.Len(); i++ {
+			v.group.Get(i).SetDisabled(!enabled)
+		}
+	}
+	return v
+}
+
+// SetInheritEnabled sets whether SetEnabled cascades disabled state down to
+// this view's buttons (the default), modeled on Domkit's ButtonGroup. With
+// this set to false, SetEnabled only affects the container itself -- whether
+// Focus passes focus on to a button or keeps it on the view -- and
+// individual buttons must be
+// disabled with RadioButton.SetDisabled.
+func (v *RadioButtonGroupView) SetInheritEnabled(inherit bool) *RadioButtonGroupView {
+	v.inheritEnabled = inherit
+	return v
+}
+
+// Focus delegates focus to the group's checked button, or its first button
+// if none is checked, matching the Chromium focus-traversal pattern of
+// sending group focus straight to the relevant member. A disabled button is
+// skipped in favor of the next enabled one (see
+// RadioButtonGroup.FocusTarget). If the group has no enabled buttons, or
+// this view is disabled, it falls back to the Box's default behavior.
+func (v *RadioButtonGroupView) Focus(delegate func(p Primitive)) {
+	if !v.enabled {
+		v.Box.Focus(delegate)
+		return
+	}
+
+	if target := v.group.FocusTarget(); target != nil {
+		delegate(target)
+		return
+	}
+
+	v.Box.Focus(delegate)
+}
+
+// Draw draws this view and its buttons.
+func (v *RadioButtonGroupView) Draw(screen tcell.Screen) {
+	v.Box.DrawForSubclass(screen, v)
+
+	x, y, width, height := v.GetInnerRect()
+	v.layout.SetRect(x, y, width, height)
+	v.layout.Draw(screen)
+}
@@ -0,0 +1,93 @@
+package tview
+
+import "testing"
+
+func TestRadioButtonGroupViewNewRadioButtonAndAddOptions(t *testing.T) {
+	v := NewRadioButtonGroupView()
+	a := v.NewRadioButton("a")
+	buttons := v.AddOptions([]string{"b", "c"})
+
+	if v.Group().Len() != 3 {
+		t.Fatalf("v.Group().Len() = %d, want 3", v.Group().Len())
+	}
+	if a.GetLabel() != "a" || buttons[0].GetLabel() != "b" || buttons[1].GetLabel() != "c" {
+		t.Fatalf("unexpected labels: %q %q %q", a.GetLabel(), buttons[0].GetLabel(), buttons[1].GetLabel())
+	}
+}
+
+func TestRadioButtonGroupViewSetOrientationChaining(t *testing.T) {
+	v := NewRadioButtonGroupView()
+	if v.SetOrientation(OrientationHorizontal) != v {
+		t.Fatal("SetOrientation should return the view for chaining")
+	}
+}
+
+func TestRadioButtonGroupViewSetEnabledCascades(t *testing.T) {
+	v := NewRadioButtonGroupView()
+	buttons := v.AddOptions([]string{"a", "b"})
+
+	v.SetEnabled(false)
+	for i, b := range buttons {
+		if !b.IsDisabled() {
+			t.Fatalf("button %d should be disabled after SetEnabled(false)", i)
+		}
+	}
+
+	v.SetEnabled(true)
+	for i, b := range buttons {
+		if b.IsDisabled() {
+			t.Fatalf("button %d should be enabled after SetEnabled(true)", i)
+		}
+	}
+
+	// Buttons added while disabled should come up disabled too.
+	v.SetEnabled(false)
+	later := v.NewRadioButton("later")
+	if !later.IsDisabled() {
+		t.Fatal("a button added while the view is disabled should start disabled")
+	}
+}
+
+func TestRadioButtonGroupViewSetInheritEnabledOptsOut(t *testing.T) {
+	v := NewRadioButtonGroupView()
+	v.SetInheritEnabled(false)
+	buttons := v.AddOptions([]string{"a", "b"})
+
+	v.SetEnabled(false)
+	for i, b := range buttons {
+		if b.IsDisabled() {
+			t.Fatalf("button %d should be unaffected by SetEnabled with inheritance opted out", i)
+		}
+	}
+}
+
+func TestRadioButtonGroupViewFocusDelegatesToCheckedOrFirst(t *testing.T) {
+	v := NewRadioButtonGroupView()
+	buttons := v.AddOptions([]string{"a", "b", "c"})
+
+	var focused Primitive
+	v.Focus(func(p Primitive) { focused = p })
+	if focused != buttons[0] {
+		t.Fatalf("Focus() with nothing checked delegated to %v, want the first button", focused)
+	}
+
+	buttons[2].SetChecked(true)
+	focused = nil
+	v.Focus(func(p Primitive) { focused = p })
+	if focused != buttons[2] {
+		t.Fatalf("Focus() delegated to %v, want the checked button", focused)
+	}
+}
+
+func TestRadioButtonGroupViewFocusSkipsDisabledButton(t *testing.T) {
+	v := NewRadioButtonGroupView()
+	buttons := v.AddOptions([]string{"a", "b", "c"})
+	buttons[0].SetChecked(true)
+	buttons[0].SetDisabled(true)
+
+	var focused Primitive
+	v.Focus(func(p Primitive) { focused = p })
+	if focused != buttons[1] {
+		t.Fatalf("Focus() delegated to %v, want the next enabled button after the disabled checked one", focused)
+	}
+}